@@ -42,6 +42,7 @@ import {
   createChatMessage,
   BlockAnnouncePayload,
   createBlockAnnounce,
+  validateBlockAnnounce,
   TxRelayPayload,
   TxRequestPayload,
   TxResponsePayload,
@@ -429,6 +430,11 @@ export class GossipNode extends EventEmitter {
 
   private handleBlockAnnounce(peerId: string, msg: GossipMessage<BlockAnnouncePayload>): void {
     const block = msg.payload;
+    const result = validateBlockAnnounce(block);
+    if (!result.valid) {
+      console.warn(`[GossipNode] Rejected block ${block.hash.slice(0, 16)}... from ${peerId.slice(0, 8)}...: ${result.error}`);
+      return;
+    }
     console.log(`[GossipNode] Block announced: height=${block.height} hash=${block.hash.slice(0, 16)}... from ${peerId.slice(0, 8)}...`);
     this.emit('block:announced', block, peerId);
   }
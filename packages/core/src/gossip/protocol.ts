@@ -12,6 +12,7 @@
  */
 
 import { createHash, randomBytes } from 'crypto';
+import { WORK_REGISTRY_VERSION } from '../mining/work-types.js';
 
 // ── Protocol Constants ─────────────────────────────────────────────
 
@@ -347,6 +348,7 @@ export interface BlockAnnouncePayload {
   nonce: number;
   version: number;
   item_count: number;
+  work_registry_version?: number;  // Absent from nodes that predate the work-type registry
 }
 
 // ── TX Relay Payloads (SPV Relay Mesh) ────────────────────────────
@@ -574,6 +576,14 @@ export function validateTransferEvent(payload: TransferEventPayload): Validation
   return { valid: true };
 }
 
+export function validateBlockAnnounce(payload: BlockAnnouncePayload): ValidationResult {
+  if (payload.work_registry_version !== undefined && payload.work_registry_version !== WORK_REGISTRY_VERSION) {
+    return { valid: false, error: `Unsupported work registry version ${payload.work_registry_version}` };
+  }
+
+  return { valid: true };
+}
+
 // ── Message Hashing ────────────────────────────────────────────────
 
 export function hashMessage(msg: GossipMessage): string {
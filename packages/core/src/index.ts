@@ -117,7 +117,8 @@ export type {
 export { ProofOfIndexingService } from "./services/mining.js";
 export type { MintBroadcaster, MintBroadcasterResult } from "./mining/broadcaster.js";
 export { MiningBridge } from "./mining/bridge.js";
-export type { WorkType, WorkItem, MempoolStatus } from "./mining/bridge.js";
+export type { WorkItem, MempoolStatus } from "./mining/bridge.js";
+export * from "./mining/work-types.js";
 
 // DNS Verification exports
 export { verifyDomainDns, generateVerificationCode, resolvePaymentAddress } from "./services/dns.js";
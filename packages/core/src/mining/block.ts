@@ -4,12 +4,13 @@
  */
 
 import { BlockHeader } from './pow.js';
+import type { WorkType } from './work-types.js';
 
 const MOVED = 'Block implementation moved to private repo (Claw-Miner-App)';
 
 export interface WorkItem {
     id: string;
-    type: 'validation' | 'serve' | 'relay' | 'heartbeat';
+    type: WorkType;
     data: any;
     timestamp: number;
 }
@@ -16,14 +16,7 @@
  */
 
 import { createHash } from 'crypto';
-
-export type WorkType =
-  | 'content'
-  | 'evaluation'
-  | 'acquisition'
-  | 'transcription'
-  | 'generation'
-  | 'serve';
+import type { WorkType } from './work-types.js';
 
 export interface WorkItem {
   type: WorkType;
@@ -1,7 +1,8 @@
 export { MiningBridge } from './bridge.js';
-export type { WorkType, WorkItem, SubmitResponse, MempoolStatus } from './bridge.js';
+export type { WorkItem, SubmitResponse, MempoolStatus } from './bridge.js';
 export { IndexerMempool, calculateMerkleRoot, createBlockTemplate } from './block.js';
 export type { WorkItem as IndexerWorkItem, IndexerBlock } from './block.js';
+export * from './work-types.js';
 export type { MintBroadcaster, MintBroadcasterResult } from './broadcaster.js';
 export {
   calculateBlockHash,
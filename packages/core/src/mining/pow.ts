@@ -13,6 +13,8 @@ export interface BlockHeader {
     bits: number;
     nonce: number;
     minerAddress: string;
+    /** WORK_REGISTRY_VERSION the block's items were weighed against */
+    workRegistryVersion?: number;
 }
 
 export interface PoWSolution {
@@ -0,0 +1,106 @@
+import { describe, it } from 'node:test';
+import assert from 'node:assert/strict';
+
+import {
+    MIN_REAL_WORK_WEIGHT,
+    WORK_REGISTRY_VERSION,
+    WORK_TYPE_REGISTRY,
+    checkBlockWork,
+    checkWorkComposition,
+} from './work-types.js';
+import type { IndexerBlock } from './block.js';
+import { validateBlockAnnounce, type BlockAnnouncePayload } from '../gossip/protocol.js';
+
+describe('checkWorkComposition', () => {
+    it('rejects unknown work types', () => {
+        const result = checkWorkComposition([{ type: 'validation' }, { type: 'bogus' }]);
+        assert.equal(result.valid, false);
+        assert.deepEqual(result.unknownTypes, ['bogus']);
+    });
+
+    it('reports duplicate unknown types once', () => {
+        const result = checkWorkComposition([{ type: 'bogus' }, { type: 'validation' }, { type: 'bogus' }]);
+        assert.deepEqual(result.unknownTypes, ['bogus']);
+    });
+
+    it('rejects a heartbeat-only block', () => {
+        const heartbeats = Array.from({ length: 10 }, () => ({ type: 'heartbeat' }));
+        const result = checkWorkComposition(heartbeats);
+        assert.equal(result.valid, false);
+        assert.equal(result.realWorkWeight, 0);
+        assert.equal(result.totalWeight, 10 * WORK_TYPE_REGISTRY.heartbeat.weight);
+    });
+
+    it('accepts real work exactly at the minimum weight', () => {
+        assert.equal(WORK_TYPE_REGISTRY.relay.weight * 2, MIN_REAL_WORK_WEIGHT);
+        const result = checkWorkComposition([{ type: 'relay' }, { type: 'relay' }]);
+        assert.equal(result.realWorkWeight, MIN_REAL_WORK_WEIGHT);
+        assert.equal(result.valid, true);
+    });
+
+    it('rejects real work one below the minimum weight', () => {
+        assert.equal(WORK_TYPE_REGISTRY.content.weight, MIN_REAL_WORK_WEIGHT - 1);
+        const result = checkWorkComposition([{ type: 'content' }, { type: 'heartbeat' }]);
+        assert.equal(result.realWorkWeight, MIN_REAL_WORK_WEIGHT - 1);
+        assert.equal(result.valid, false);
+    });
+
+    it('accepts every type the MiningBridge submits', () => {
+        const bridgeTypes = ['content', 'evaluation', 'acquisition', 'transcription', 'generation', 'serve'];
+        const result = checkWorkComposition(bridgeTypes.map((type) => ({ type })));
+        assert.deepEqual(result.unknownTypes, []);
+        assert.equal(result.valid, true);
+    });
+});
+
+describe('checkBlockWork', () => {
+    const block = (workRegistryVersion?: number): IndexerBlock => ({
+        header: {
+            version: 1,
+            prevHash: '00'.repeat(32),
+            merkleRoot: '00'.repeat(32),
+            timestamp: 0,
+            bits: 0,
+            nonce: 0,
+            minerAddress: '1Miner',
+            workRegistryVersion,
+        },
+        items: [{ id: 'a', type: 'validation', data: null, timestamp: 0 }],
+        hash: '00'.repeat(32),
+    });
+
+    it('accepts a block weighed against the current registry', () => {
+        assert.equal(checkBlockWork(block(WORK_REGISTRY_VERSION)).valid, true);
+    });
+
+    it('rejects a block with a missing or different registry version', () => {
+        assert.equal(checkBlockWork(block()).valid, false);
+        assert.equal(checkBlockWork(block(WORK_REGISTRY_VERSION + 1)).valid, false);
+    });
+});
+
+describe('validateBlockAnnounce', () => {
+    const announce = (work_registry_version?: number): BlockAnnouncePayload => ({
+        hash: '00'.repeat(32),
+        height: 1,
+        miner_address: '1Miner',
+        timestamp: 0,
+        bits: 0,
+        target: 'ff'.repeat(32),
+        merkle_root: '00'.repeat(32),
+        prev_hash: '00'.repeat(32),
+        nonce: 0,
+        version: 1,
+        item_count: 1,
+        work_registry_version,
+    });
+
+    it('accepts announces without a registry version', () => {
+        assert.equal(validateBlockAnnounce(announce()).valid, true);
+    });
+
+    it('rejects announces with a different registry version', () => {
+        assert.equal(validateBlockAnnounce(announce(WORK_REGISTRY_VERSION)).valid, true);
+        assert.equal(validateBlockAnnounce(announce(WORK_REGISTRY_VERSION + 1)).valid, false);
+    });
+});
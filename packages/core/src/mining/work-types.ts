@@ -0,0 +1,96 @@
+/**
+ * Work-type registry — the one list of work item types and how much each
+ * counts toward a block.
+ *
+ * MiningBridge submissions and IndexerBlock items both use these types.
+ * The weights and MIN_REAL_WORK_WEIGHT are provisional: they have not yet
+ * been agreed with the Go miner. Bump WORK_REGISTRY_VERSION on any change.
+ */
+
+import type { IndexerBlock } from './block.js';
+
+export type WorkType =
+    | 'content'
+    | 'evaluation'
+    | 'acquisition'
+    | 'transcription'
+    | 'generation'
+    | 'serve'
+    | 'validation'
+    | 'relay'
+    | 'heartbeat';
+
+export interface WorkTypeSpec {
+    weight: number;
+    /** Heartbeats prove liveness, not work, so they don't count toward MIN_REAL_WORK_WEIGHT */
+    realWork: boolean;
+}
+
+export const WORK_REGISTRY_VERSION = 1;
+
+export const WORK_TYPE_REGISTRY: Readonly<Record<WorkType, Readonly<WorkTypeSpec>>> = {
+    content: { weight: 3, realWork: true },
+    evaluation: { weight: 2, realWork: true },
+    acquisition: { weight: 2, realWork: true },
+    transcription: { weight: 3, realWork: true },
+    generation: { weight: 3, realWork: true },
+    serve: { weight: 3, realWork: true },       // content served to a peer
+    validation: { weight: 4, realWork: true },
+    relay: { weight: 2, realWork: true },
+    heartbeat: { weight: 1, realWork: false },
+};
+
+/** A block must carry at least this much real-work weight to be valid */
+export const MIN_REAL_WORK_WEIGHT = 4;
+
+export interface WorkComposition {
+    registryVersion: number;
+    totalWeight: number;
+    realWorkWeight: number;
+    unknownTypes: string[];
+    valid: boolean;
+}
+
+export function isWorkType(type: string): type is WorkType {
+    return Object.prototype.hasOwnProperty.call(WORK_TYPE_REGISTRY, type);
+}
+
+/**
+ * Weigh a set of items against the registry. Invalid if any type is unknown
+ * or the real-work weight falls short of MIN_REAL_WORK_WEIGHT.
+ */
+export function checkWorkComposition(items: ReadonlyArray<{ type: string }>): WorkComposition {
+    let totalWeight = 0;
+    let realWorkWeight = 0;
+    const unknownTypes = new Set<string>();
+
+    for (const item of items) {
+        if (!isWorkType(item.type)) {
+            unknownTypes.add(item.type);
+            continue;
+        }
+        const spec = WORK_TYPE_REGISTRY[item.type];
+        totalWeight += spec.weight;
+        if (spec.realWork) realWorkWeight += spec.weight;
+    }
+
+    return {
+        registryVersion: WORK_REGISTRY_VERSION,
+        totalWeight,
+        realWorkWeight,
+        unknownTypes: [...unknownTypes],
+        valid: unknownTypes.size === 0 && realWorkWeight >= MIN_REAL_WORK_WEIGHT,
+    };
+}
+
+/**
+ * Check a full block: its header must name this registry version and its
+ * items must pass checkWorkComposition.
+ */
+export function checkBlockWork(block: IndexerBlock): WorkComposition {
+    const composition = checkWorkComposition(block.items);
+    if (block.header.workRegistryVersion !== WORK_REGISTRY_VERSION) {
+        return { ...composition, valid: false };
+    }
+    return composition;
+}